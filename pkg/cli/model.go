@@ -0,0 +1,115 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onosproject/onos-config/pkg/northbound/admin"
+	"github.com/spf13/cobra"
+)
+
+// getModelCommand builds the "model" command and its unload/reload subcommands
+func getModelCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "model",
+		Short: "Manages model plugins loaded by onos-config",
+	}
+	cmd.AddCommand(getModelUnloadCommand())
+	cmd.AddCommand(getModelReloadCommand())
+	cmd.AddCommand(getModelPathMetadataCommand())
+	return cmd
+}
+
+func getModelUnloadCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unload <modelname>",
+		Short: "Unloads a model plugin",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runModelUnloadCommand,
+	}
+}
+
+func runModelUnloadCommand(cmd *cobra.Command, args []string) error {
+	client, err := getConfigAdminServiceClient()
+	if err != nil {
+		return err
+	}
+	if _, err := client.UnregisterModelPlugin(context.Background(), &admin.UnregisterModelPluginRequest{ModelName: args[0]}); err != nil {
+		return fmt.Errorf("failed to unload model plugin %s: %v", args[0], err)
+	}
+	Output("Unloaded model plugin %s\n", args[0])
+	return nil
+}
+
+func getModelReloadCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reload <modelname> <path>",
+		Short: "Reloads a model plugin from a (possibly rebuilt or re-versioned) executable",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runModelReloadCommand,
+	}
+}
+
+func runModelReloadCommand(cmd *cobra.Command, args []string) error {
+	client, err := getConfigAdminServiceClient()
+	if err != nil {
+		return err
+	}
+	reply, err := client.ReloadModelPlugin(context.Background(), &admin.ReloadModelPluginRequest{ModelName: args[0], Path: args[1]})
+	if err != nil {
+		return fmt.Errorf("failed to reload model plugin %s: %v", args[0], err)
+	}
+	Output("Reloaded model plugin %s %s\n", reply.Name, reply.Version)
+	return nil
+}
+
+func getModelPathMetadataCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "path-metadata <modelname> <path>",
+		Short: "Gets the type and constraint metadata for a path of a loaded model",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runModelPathMetadataCommand,
+	}
+}
+
+func runModelPathMetadataCommand(cmd *cobra.Command, args []string) error {
+	client, err := getConfigAdminServiceClient()
+	if err != nil {
+		return err
+	}
+	reply, err := client.GetPathMetadata(context.Background(), &admin.GetPathMetadataRequest{ModelName: args[0], Path: args[1]})
+	if err != nil {
+		return fmt.Errorf("failed to get path metadata for %s on model %s: %v", args[1], args[0], err)
+	}
+	Output("%-80s|%-10s|%-20s|%s\n", reply.Path, configOrState(reply.IsConfig), reply.Type, reply.Description)
+	return nil
+}
+
+func configOrState(isConfig bool) string {
+	if isConfig {
+		return "config"
+	}
+	return "state"
+}
+
+func getConfigAdminServiceClient() (admin.ConfigAdminServiceClient, error) {
+	clientConnection, err := getConnection()
+	if err != nil {
+		return nil, err
+	}
+	return admin.NewConfigAdminServiceClient(clientConnection), nil
+}
@@ -16,56 +16,159 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/onosproject/onos-config/pkg/northbound/diags"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
 	"io"
+	"regexp"
+	"strings"
 	"text/template"
 )
 
 const opstateTemplate = "{{wrappath .Pathvalue.Path 80 0| printf \"%-80s|\"}}" +
 	"{{nativeType .Pathvalue | printf \"(%s) %s\" .Pathvalue.ValueType | printf \"%-20s|\" }}"
 
+const syncResponseMarker = "--- sync_response: initial snapshot complete ---"
+
 func getGetOpstateCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "opstate <deviceid> [--subscribe]",
+		Use:   "opstate <deviceid> [--mode=once|poll|stream] [--path=<path>]...",
 		Short: "Gets the Opstate cache for a device",
 		Args:  cobra.ExactArgs(1),
 		RunE:  runOpstateCommand,
 	}
-	cmd.Flags().BoolP("subscribe", "s", false, "subscribe for subsequent changes")
+	cmd.Flags().String("mode", "once", "gNMI Subscribe mode: once, poll or stream")
+	cmd.Flags().String("stream-mode", "target-defined", "stream subscription mode: sample, on-change or target-defined")
+	cmd.Flags().Duration("sample-interval", 0, "interval between samples, when --stream-mode=sample")
+	cmd.Flags().Duration("heartbeat-interval", 0, "interval between forced updates, when --stream-mode=on-change")
+	cmd.Flags().Bool("suppress-redundant", false, "suppress samples that duplicate the last reported value, when --stream-mode=sample")
+	cmd.Flags().StringArray("path", nil, "path, with optional '*' glob wildcards, to restrict the subscription to; repeatable")
+	cmd.Flags().StringP("format", "f", "table", "output format: table, json or yaml")
 	return cmd
 }
 
 func runOpstateCommand(cmd *cobra.Command, args []string) error {
 	deviceID := args[0]
-	subscribe, _ := cmd.Flags().GetBool("subscribe")
-	tmplGetOpState, _ := template.New("change").Funcs(funcMapChanges).Parse(opstateTemplate)
-	clientConnection, clientConnectionError := getConnection()
+	req, err := newOpStateRequest(cmd, deviceID)
+	if err != nil {
+		return err
+	}
+	format, _ := cmd.Flags().GetString("format")
 
+	clientConnection, clientConnectionError := getConnection()
 	if clientConnectionError != nil {
 		return clientConnectionError
 	}
 	client := diags.CreateOpStateDiagsClient(clientConnection)
 
-	Output("OPSTATE CACHE: %s\n", deviceID)
-	Output("%-82s|%-20s|\n", "PATH", "VALUE")
-
-	stream, err := client.GetOpState(context.Background(), &diags.OpStateRequest{DeviceId: deviceID, Subscribe: subscribe})
+	stream, err := client.GetOpState(context.Background(), req)
 	if err != nil {
 		return fmt.Errorf("failed to send request: %v", err)
 	}
 
+	return renderOpStateStream(stream, deviceID, req.Paths, format)
+}
+
+// newOpStateRequest builds a diags.OpStateRequest from the command's flags, mapping the
+// gNMI-style --mode/--stream-mode names onto the request's Subscribe mode enums
+func newOpStateRequest(cmd *cobra.Command, deviceID string) (*diags.OpStateRequest, error) {
+	mode, _ := cmd.Flags().GetString("mode")
+	streamMode, _ := cmd.Flags().GetString("stream-mode")
+	sampleInterval, _ := cmd.Flags().GetDuration("sample-interval")
+	heartbeatInterval, _ := cmd.Flags().GetDuration("heartbeat-interval")
+	suppressRedundant, _ := cmd.Flags().GetBool("suppress-redundant")
+	paths, _ := cmd.Flags().GetStringArray("path")
+
+	subscribeMode, ok := diags.SubscribeMode_value[strings.ToUpper(mode)]
+	if !ok {
+		return nil, fmt.Errorf("invalid --mode %q: must be once, poll or stream", mode)
+	}
+	subscriptionMode, ok := diags.SubscriptionMode_value[strings.ToUpper(strings.ReplaceAll(streamMode, "-", "_"))]
+	if !ok {
+		return nil, fmt.Errorf("invalid --stream-mode %q: must be sample, on-change or target-defined", streamMode)
+	}
+
+	return &diags.OpStateRequest{
+		DeviceId:          deviceID,
+		Mode:              diags.SubscribeMode(subscribeMode),
+		StreamMode:        diags.SubscriptionMode(subscriptionMode),
+		SampleInterval:    uint64(sampleInterval.Nanoseconds()),
+		HeartbeatInterval: uint64(heartbeatInterval.Nanoseconds()),
+		SuppressRedundant: suppressRedundant,
+		Paths:             paths,
+	}, nil
+}
+
+// renderOpStateStream reads responses from the opstate stream, filters them to the requested
+// paths and renders them in the requested format. A sync_response with no Pathvalue marks the
+// boundary between the initial snapshot and subsequent updates
+func renderOpStateStream(stream diags.OpStateDiags_GetOpStateClient, deviceID string, paths []string, format string) error {
+	tmplGetOpState, err := template.New("opstate").Funcs(funcMapChanges).Parse(opstateTemplate)
+	if err != nil {
+		return err
+	}
+
+	if format == "table" {
+		Output("OPSTATE CACHE: %s\n", deviceID)
+		Output("%-82s|%-20s|\n", "PATH", "VALUE")
+	}
+
 	for {
 		in, err := stream.Recv()
 		if err == io.EOF {
-			// read done.
 			return nil
 		}
 		if err != nil {
 			return err
 		}
-		_ = tmplGetOpState.Execute(GetOutput(), in)
-		Output("\n")
+
+		if in.SyncResponse {
+			if format == "table" {
+				Output("%s\n", syncResponseMarker)
+			}
+			continue
+		}
+
+		if !pathMatchesFilters(in.Pathvalue.Path, paths) {
+			continue
+		}
+
+		switch format {
+		case "json":
+			out, err := json.Marshal(in)
+			if err != nil {
+				return err
+			}
+			Output("%s\n", out)
+		case "yaml":
+			out, err := yaml.Marshal(in)
+			if err != nil {
+				return err
+			}
+			Output("%s\n", out)
+		default:
+			_ = tmplGetOpState.Execute(GetOutput(), in)
+			Output("\n")
+		}
+	}
+}
+
+// pathMatchesFilters returns true if path matches at least one of filters, or if filters is
+// empty. Filters may use '*' as a glob wildcard, for example to match any list key value, but
+// the actual path is matched as-is: it must not be run through RemovePathIndices first, or every
+// list instance would collapse to the same "[name=*]" placeholder and become indistinguishable
+// from a genuine wildcard filter
+func pathMatchesFilters(path string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(filter), `\*`, ".*") + "$"
+		if matched, _ := regexp.MatchString(pattern, path); matched {
+			return true
+		}
 	}
+	return false
 }
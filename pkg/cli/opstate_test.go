@@ -0,0 +1,71 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import "testing"
+
+func Test_pathMatchesFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		filters []string
+		want    bool
+	}{
+		{
+			name:    "no filters matches everything",
+			path:    "/interfaces/interface[name=eth0]/state/oper-status",
+			filters: nil,
+			want:    true,
+		},
+		{
+			name:    "exact path matches",
+			path:    "/interfaces/interface[name=eth0]/state/oper-status",
+			filters: []string{"/interfaces/interface[name=eth0]/state/oper-status"},
+			want:    true,
+		},
+		{
+			name:    "exact path filter does not match a different key value",
+			path:    "/interfaces/interface[name=eth1]/state/oper-status",
+			filters: []string{"/interfaces/interface[name=eth0]/state/oper-status"},
+			want:    false,
+		},
+		{
+			name:    "glob wildcard on the key value matches any instance",
+			path:    "/interfaces/interface[name=eth1]/state/oper-status",
+			filters: []string{"/interfaces/interface[name=*]/state/oper-status"},
+			want:    true,
+		},
+		{
+			name:    "matches against at least one of several filters",
+			path:    "/interfaces/interface[name=eth1]/state/oper-status",
+			filters: []string{"/system/state/hostname", "/interfaces/interface[name=eth1]/state/oper-status"},
+			want:    true,
+		},
+		{
+			name:    "no filter matches",
+			path:    "/interfaces/interface[name=eth1]/state/oper-status",
+			filters: []string{"/system/state/hostname"},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pathMatchesFilters(tt.path, tt.filters); got != tt.want {
+				t.Errorf("pathMatchesFilters(%q, %v) = %v, want %v", tt.path, tt.filters, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// PathInfo describes the type and constraint metadata extracted from the YANG schema for a
+// single path of a model, so that clients can validate or typecheck a value locally before
+// issuing a gNMI Set RPC instead of discovering errors only at device-apply time
+type PathInfo struct {
+	Path        string
+	IsConfig    bool
+	Type        string
+	Units       string
+	Default     string
+	Description string
+	Mandatory   bool
+	MinElements uint64
+	Enum        []string
+	LeafRefPath string
+	LeafRefType string
+}
+
+// ModelPathMetadata is a map of path to PathInfo for every leaf and list path of a single model
+type ModelPathMetadata map[string]*PathInfo
+
+// PathMetadata returns the type and constraint metadata for a single path of a model, as
+// extracted from the model's YANG schema when its plugin was registered. It is also reachable
+// from outside the process through admin.Server.GetPathMetadata
+func (m *Manager) PathMetadata(modelName string, path string) (*PathInfo, error) {
+	metadata, ok := m.ModelPathMetadata[modelName]
+	if !ok {
+		return nil, fmt.Errorf("model %s not found", modelName)
+	}
+	info, ok := metadata[RemovePathIndices(path)]
+	if !ok {
+		return nil, fmt.Errorf("path %s not found in model %s", path, modelName)
+	}
+	return info, nil
+}
+
+// extractPathMetadata is a recursive function to extract type and constraint metadata for every
+// leaf and list path from a YGOT schema
+func extractPathMetadata(deviceEntry *yang.Entry, parentState yang.TriState, parentNs string, parentPath string) ModelPathMetadata {
+	metadata := make(ModelPathMetadata)
+
+	for _, dirEntry := range deviceEntry.Dir {
+		namespace := extractnamespace(dirEntry, parentNs)
+		itemPath := formatName(dirEntry, false, parentNs, parentPath)
+		isConfig := dirEntry.Config != yang.TSFalse && parentState != yang.TSFalse
+		childState := dirEntry.Config
+		if !isConfig {
+			childState = yang.TSFalse // Everything below a "config false" entry is read only too
+		}
+
+		if dirEntry.IsLeaf() {
+			metadata[itemPath] = newPathInfo(dirEntry, itemPath, isConfig)
+		} else if dirEntry.IsContainer() {
+			metadata[itemPath] = newPathInfo(dirEntry, itemPath, isConfig)
+			for childPath, childInfo := range extractPathMetadata(dirEntry, childState, namespace, itemPath) {
+				metadata[childPath] = childInfo
+			}
+		} else if dirEntry.IsList() {
+			itemPath = formatName(dirEntry, true, parentNs, parentPath)
+			metadata[itemPath] = newPathInfo(dirEntry, itemPath, isConfig)
+			for childPath, childInfo := range extractPathMetadata(dirEntry, childState, namespace, itemPath) {
+				metadata[childPath] = childInfo
+			}
+		}
+	}
+
+	return metadata
+}
+
+// newPathInfo builds the PathInfo for a single YANG schema entry, resolving leafref targets and
+// enum value sets where the entry's type calls for it
+func newPathInfo(dirEntry *yang.Entry, itemPath string, isConfig bool) *PathInfo {
+	info := &PathInfo{
+		Path:        itemPath,
+		IsConfig:    isConfig,
+		Description: dirEntry.Description,
+		Mandatory:   dirEntry.Mandatory == yang.TSTrue,
+	}
+
+	if dirEntry.ListAttr != nil {
+		info.MinElements = dirEntry.ListAttr.MinElements
+	}
+
+	yangType := dirEntry.Type
+	if yangType == nil {
+		return info
+	}
+
+	info.Type = yangType.Name
+	info.Units = yangType.Units
+	if len(yangType.Default) > 0 {
+		info.Default = yangType.Default[0]
+	}
+
+	if yangType.Enum != nil {
+		info.Enum = yangType.Enum.Names()
+	}
+
+	if yangType.Kind == yang.Yleafref && yangType.Path != "" {
+		info.LeafRefPath = yangType.Path
+		if target := dirEntry.Find(yangType.Path); target != nil && target.Type != nil {
+			info.LeafRefType = target.Type.Name
+		}
+	}
+
+	return info
+}
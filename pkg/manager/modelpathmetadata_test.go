@@ -0,0 +1,147 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"testing"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// buildTestDeviceSchema builds a small synthetic schema with a config leaf, a read-only leaf
+// with an enum type, and a min-elements-constrained list with a mandatory key leaf, so that
+// extractPathMetadata exercises all of the PathInfo fields it is responsible for filling in.
+// Every entry carries a Prefix, as entries of a real YGOT schema do, since the namespace lookup
+// used to build each path reads it
+func buildTestDeviceSchema(t *testing.T) *yang.Entry {
+	t.Helper()
+
+	prefix := &yang.Value{Name: "test"}
+
+	enumType := yang.NewEnumType()
+	if err := enumType.Set("UP", 1); err != nil {
+		t.Fatalf("failed to build enum type: %v", err)
+	}
+	if err := enumType.Set("DOWN", 2); err != nil {
+		t.Fatalf("failed to build enum type: %v", err)
+	}
+
+	hostname := &yang.Entry{
+		Name:   "hostname",
+		Kind:   yang.LeafEntry,
+		Config: yang.TSTrue,
+		Prefix: prefix,
+		Type: &yang.YangType{
+			Name:    "string",
+			Default: []string{"localhost"},
+		},
+		Description: "the configured hostname of the device",
+	}
+
+	operStatus := &yang.Entry{
+		Name:   "oper-status",
+		Kind:   yang.LeafEntry,
+		Config: yang.TSFalse,
+		Prefix: prefix,
+		Type: &yang.YangType{
+			Name: "enumeration",
+			Kind: yang.Yenum,
+			Enum: enumType,
+		},
+	}
+
+	name := &yang.Entry{
+		Name:      "name",
+		Kind:      yang.LeafEntry,
+		Config:    yang.TSTrue,
+		Mandatory: yang.TSTrue,
+		Prefix:    prefix,
+		Type:      &yang.YangType{Name: "string"},
+	}
+
+	iface := &yang.Entry{
+		Name:     "interface",
+		Kind:     yang.DirectoryEntry,
+		Config:   yang.TSTrue,
+		Key:      "name",
+		Prefix:   prefix,
+		ListAttr: &yang.ListAttr{MinElements: 1},
+		Dir: map[string]*yang.Entry{
+			"name":        name,
+			"oper-status": operStatus,
+		},
+	}
+
+	interfaces := &yang.Entry{
+		Name:   "interfaces",
+		Kind:   yang.DirectoryEntry,
+		Config: yang.TSTrue,
+		Prefix: prefix,
+		Dir:    map[string]*yang.Entry{"interface": iface},
+	}
+
+	return &yang.Entry{
+		Name:   "Device",
+		Kind:   yang.DirectoryEntry,
+		Config: yang.TSTrue,
+		Dir: map[string]*yang.Entry{
+			"hostname":   hostname,
+			"interfaces": interfaces,
+		},
+	}
+}
+
+func Test_extractPathMetadata(t *testing.T) {
+	device := buildTestDeviceSchema(t)
+	metadata := extractPathMetadata(device, yang.TSUnset, "", "")
+
+	hostnameInfo, ok := metadata["/hostname"]
+	if !ok {
+		t.Fatal("expected metadata for /hostname")
+	}
+	if !hostnameInfo.IsConfig {
+		t.Error("expected /hostname to be config")
+	}
+	if hostnameInfo.Default != "localhost" {
+		t.Errorf("expected /hostname default localhost, got %q", hostnameInfo.Default)
+	}
+
+	operStatusInfo, ok := metadata["/interfaces/interface[name=*]/oper-status"]
+	if !ok {
+		t.Fatal("expected metadata for /interfaces/interface[name=*]/oper-status")
+	}
+	if operStatusInfo.IsConfig {
+		t.Error("expected oper-status to be read-only")
+	}
+	if len(operStatusInfo.Enum) != 2 {
+		t.Errorf("expected 2 enum values for oper-status, got %v", operStatusInfo.Enum)
+	}
+
+	ifaceInfo, ok := metadata["/interfaces/interface[name=*]"]
+	if !ok {
+		t.Fatal("expected metadata for the interface list itself")
+	}
+	if ifaceInfo.MinElements != 1 {
+		t.Errorf("expected MinElements 1 for the interface list, got %d", ifaceInfo.MinElements)
+	}
+
+	nameInfo, ok := metadata["/interfaces/interface[name=*]/name"]
+	if !ok {
+		t.Fatal("expected metadata for /interfaces/interface[name=*]/name")
+	}
+	if !nameInfo.Mandatory {
+		t.Error("expected the interface key leaf to be mandatory")
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"github.com/onosproject/onos-config/pkg/northbound/pluginregistry"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc"
+	log "k8s.io/klog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+// pluginCallTimeout bounds every call to a model plugin process so that a wedged plugin cannot
+// hang the caller indefinitely
+const pluginCallTimeout = 10 * time.Second
+
+// modelPluginProcess is a ModelPlugin backed by a child process that was started from the
+// plugin's executable and exposes the ModelPlugin interface over a local gRPC socket, rather
+// than a symbol loaded from an in-process Go plugin .so. Because a .so can never be unloaded
+// from a running process, this is the mechanism that makes UnregisterModelPlugin and
+// ReloadModelPlugin possible
+type modelPluginProcess struct {
+	path   string
+	socket string
+	cmd    *exec.Cmd
+	conn   *grpc.ClientConn
+	client pluginregistry.ModelPluginServiceClient
+}
+
+// startModelPluginProcess launches modulePath as a child process and dials the gRPC socket it is
+// expected to expose, retrying briefly while the process comes up
+func startModelPluginProcess(modulePath string) (*modelPluginProcess, error) {
+	return startModelPluginProcessWithEnv(modulePath, nil)
+}
+
+// startModelPluginProcessWithEnv is startModelPluginProcess with additional environment
+// variables appended to the child's inherited environment. It exists so tests can re-exec the
+// test binary itself as a stand-in model plugin process, gated behind an env var the production
+// path never sets
+func startModelPluginProcessWithEnv(modulePath string, extraEnv []string) (*modelPluginProcess, error) {
+	socket := filepath.Join(os.TempDir(), fmt.Sprintf("modelplugin-%d.sock", time.Now().UnixNano()))
+	cmd := exec.Command(modulePath, "-socket", socket)
+	if len(extraEnv) > 0 {
+		cmd.Env = append(os.Environ(), extraEnv...)
+	}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start model plugin process %s: %w", modulePath, err)
+	}
+
+	conn, err := dialModelPluginSocket(socket)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		return nil, err
+	}
+
+	return &modelPluginProcess{
+		path:   modulePath,
+		socket: socket,
+		cmd:    cmd,
+		conn:   conn,
+		client: pluginregistry.NewModelPluginServiceClient(conn),
+	}, nil
+}
+
+func dialModelPluginSocket(socket string) (*grpc.ClientConn, error) {
+	const dialTimeout = 5 * time.Second
+	deadline := time.Now().Add(dialTimeout)
+	var lastErr error
+	for time.Now().Before(deadline) {
+		conn, err := grpc.Dial("unix://"+socket, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(250*time.Millisecond))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+		time.Sleep(50 * time.Millisecond)
+	}
+	return nil, fmt.Errorf("unable to connect to model plugin socket %s: %w", socket, lastErr)
+}
+
+// stop terminates the plugin process and releases its gRPC connection
+func (p *modelPluginProcess) stop() {
+	if p.conn != nil {
+		if err := p.conn.Close(); err != nil {
+			log.Warning("Error closing connection to model plugin ", p.path, err)
+		}
+	}
+	if p.cmd != nil && p.cmd.Process != nil {
+		if err := p.cmd.Process.Kill(); err != nil {
+			log.Warning("Error stopping model plugin process ", p.path, err)
+		}
+		_ = p.cmd.Wait() // reap the process so it doesn't linger as a zombie
+	}
+	_ = os.Remove(p.socket)
+}
+
+// remoteGoStruct is a client-side handle for a config tree that was decoded and kept inside a
+// model plugin process, rather than a real generated Go struct. The manager process has no way
+// to know the concrete struct type of an arbitrary plugin, so it cannot decode the tree itself;
+// instead it holds onto the opaque handle the process returned from UnmarshalConfigValues and
+// passes it back on Validate. GoStruct's only method is the IsYANGGoStruct marker, so
+// remoteGoStruct satisfies ygot.ValidatedGoStruct without needing to implement the real struct
+type remoteGoStruct struct {
+	process *modelPluginProcess
+	handle  string
+}
+
+func (r *remoteGoStruct) IsYANGGoStruct() {}
+
+func (r *remoteGoStruct) Validate(opts ...ygot.ValidationOption) error {
+	return r.process.validateHandle(r.handle, opts)
+}
+
+func (p *modelPluginProcess) ModelData() (string, string, []*gnmi.ModelData, string) {
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	reply, err := p.client.ModelData(ctx, &pluginregistry.ModelDataRequest{})
+	if err != nil {
+		log.Warning("Error fetching ModelData from model plugin process ", p.path, err)
+		return "", "", nil, ""
+	}
+	return reply.Name, reply.Version, reply.Models, reply.Module
+}
+
+func (p *modelPluginProcess) UnmarshalConfigValues(jsonTree []byte) (*ygot.ValidatedGoStruct, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	reply, err := p.client.UnmarshalConfigValues(ctx, &pluginregistry.UnmarshalConfigValuesRequest{JsonTree: jsonTree})
+	if err != nil {
+		return nil, err
+	}
+	var goStruct ygot.ValidatedGoStruct = &remoteGoStruct{process: p, handle: reply.Handle}
+	return &goStruct, nil
+}
+
+func (p *modelPluginProcess) Validate(goStruct *ygot.ValidatedGoStruct, opts ...ygot.ValidationOption) error {
+	remote, ok := (*goStruct).(*remoteGoStruct)
+	if !ok {
+		return fmt.Errorf("model plugin process %s cannot validate a struct it did not unmarshal", p.path)
+	}
+	return remote.Validate(opts...)
+}
+
+// validateHandle asks the plugin process to validate the config tree it is holding under handle,
+// the reference returned from its own UnmarshalConfigValues call
+func (p *modelPluginProcess) validateHandle(handle string, opts []ygot.ValidationOption) error {
+	if len(opts) > 0 {
+		return fmt.Errorf("model plugin process %s: validation options are not supported across the process boundary", p.path)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	_, err := p.client.Validate(ctx, &pluginregistry.ValidateRequest{Handle: handle})
+	return err
+}
+
+func (p *modelPluginProcess) Schema() (map[string]*yang.Entry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	reply, err := p.client.Schema(ctx, &pluginregistry.SchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return pluginregistry.DecodeSchema(reply.EntriesJson)
+}
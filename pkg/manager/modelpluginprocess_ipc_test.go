@@ -0,0 +1,132 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/onosproject/onos-config/pkg/northbound/pluginregistry"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// fakeModelPluginHelperEnv, when set in the test binary's own environment, tells TestMain to run
+// as a model plugin process instead of the test suite. startModelPluginProcess launches whatever
+// executable it is given and passes it "-socket <path>", so re-executing the test binary itself
+// with this env var set (passed to the child alone, through startModelPluginProcessWithEnv's
+// extraEnv) turns it into a real, separate model plugin process without needing a second build
+// artifact
+const fakeModelPluginHelperEnv = "MODEL_PLUGIN_IPC_TEST_HELPER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(fakeModelPluginHelperEnv) != "" {
+		runFakeModelPluginHelper()
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// runFakeModelPluginHelper serves a fakeModelPlugin on the socket passed via the same "-socket"
+// flag startModelPluginProcess uses in production, then blocks until the parent test kills it
+func runFakeModelPluginHelper() {
+	socket := flag.String("socket", "", "unix socket to serve the fake model plugin on")
+	flag.Parse()
+	if *socket == "" {
+		fmt.Fprintln(os.Stderr, "missing -socket")
+		os.Exit(1)
+	}
+	if err := pluginregistry.Serve(*socket, &fakeModelPlugin{}); err != nil {
+		fmt.Fprintln(os.Stderr, "fake model plugin exited:", err)
+		os.Exit(1)
+	}
+}
+
+// fakeModelPlugin is a minimal pluginregistry.ModelPlugin used to exercise the real IPC path:
+// starting the helper as a child process, dialling it over a unix socket, and round-tripping
+// ModelData, Schema, UnmarshalConfigValues and Validate calls through actual gRPC
+type fakeModelPlugin struct{}
+
+func (*fakeModelPlugin) ModelData() (string, string, []*gnmi.ModelData, string) {
+	return "fake", "1.0.0", []*gnmi.ModelData{{Name: "fake", Version: "1.0.0"}}, "fake-module"
+}
+
+func (*fakeModelPlugin) Schema() (map[string]*yang.Entry, error) {
+	root := &yang.Entry{Name: "Device", Dir: map[string]*yang.Entry{}}
+	leaf := &yang.Entry{Name: "hostname", Parent: root, Type: &yang.YangType{Name: "string"}}
+	root.Dir["hostname"] = leaf
+	return map[string]*yang.Entry{"Device": root}, nil
+}
+
+func (*fakeModelPlugin) UnmarshalConfigValues(jsonTree []byte) (*ygot.ValidatedGoStruct, error) {
+	var goStruct ygot.ValidatedGoStruct = &fakeGoStruct{jsonTree: jsonTree}
+	return &goStruct, nil
+}
+
+func (*fakeModelPlugin) Validate(goStruct *ygot.ValidatedGoStruct, opts ...ygot.ValidationOption) error {
+	fake, ok := (*goStruct).(*fakeGoStruct)
+	if !ok || len(fake.jsonTree) == 0 {
+		return fmt.Errorf("fake model plugin: nothing to validate")
+	}
+	return nil
+}
+
+type fakeGoStruct struct {
+	jsonTree []byte
+}
+
+func (*fakeGoStruct) IsYANGGoStruct() {}
+
+func (*fakeGoStruct) Validate(...ygot.ValidationOption) error { return nil }
+
+func Test_modelPluginProcess_IPC(t *testing.T) {
+	process, err := startModelPluginProcessWithEnv(os.Args[0], []string{fakeModelPluginHelperEnv + "=1"})
+	if err != nil {
+		t.Fatalf("unable to start fake model plugin process: %v", err)
+	}
+	t.Cleanup(process.stop)
+
+	name, version, models, module := process.ModelData()
+	if name != "fake" || version != "1.0.0" || module != "fake-module" || len(models) != 1 {
+		t.Errorf("ModelData() = %q %q %v %q, want fake 1.0.0 [...] fake-module", name, version, models, module)
+	}
+
+	schema, err := process.Schema()
+	if err != nil {
+		t.Fatalf("Schema() error = %v", err)
+	}
+	device, ok := schema["Device"]
+	if !ok {
+		t.Fatal("Schema() did not return a Device entry")
+	}
+	hostname, ok := device.Dir["hostname"]
+	if !ok {
+		t.Fatal("Schema() Device entry is missing the hostname child")
+	}
+	if hostname.Parent != device {
+		t.Error("Schema() did not relink Parent on the decoded entry")
+	}
+
+	goStruct, err := process.UnmarshalConfigValues([]byte(`{"hostname":"example"}`))
+	if err != nil {
+		t.Fatalf("UnmarshalConfigValues() error = %v", err)
+	}
+	if err := process.Validate(goStruct); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
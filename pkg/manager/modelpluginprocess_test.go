@@ -0,0 +1,30 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import "testing"
+
+func Test_startModelPluginProcess_missingExecutable(t *testing.T) {
+	process, err := startModelPluginProcess("/no/such/model-plugin-binary")
+	if err == nil {
+		if process != nil {
+			process.stop()
+		}
+		t.Fatal("expected an error starting a model plugin process from a nonexistent path")
+	}
+	if process != nil {
+		t.Error("expected a nil *modelPluginProcess on error")
+	}
+}
@@ -21,7 +21,6 @@ import (
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/ygot"
 	log "k8s.io/klog"
-	"plugin"
 	"regexp"
 	"strings"
 )
@@ -34,42 +33,103 @@ type ModelPlugin interface {
 	Schema() (map[string]*yang.Entry, error)
 }
 
-// RegisterModelPlugin adds an external model plugin to the model registry at startup
-// or through the 'admin' gRPC interface. Once plugins are loaded they cannot be unloaded
+// RegisterModelPlugin starts moduleName as a model plugin child process and adds it to the
+// model registry at startup or through the 'admin' gRPC interface. Model plugins are no longer
+// loaded with Go's plugin package, so they can later be stopped with UnregisterModelPlugin or
+// swapped out with ReloadModelPlugin. Multiple versions of the same model name may be
+// registered at once; each is kept under its own utils.ToModelName key
 func (m *Manager) RegisterModelPlugin(moduleName string) (string, string, error) {
 	log.Info("Loading module ", moduleName)
-	modelPluginModule, err := plugin.Open(moduleName)
+	modelPlugin, err := startModelPluginProcess(moduleName)
 	if err != nil {
-		log.Warning("Unable to load module ", moduleName)
+		log.Warning("Unable to start model plugin process ", moduleName, err)
 		return "", "", err
 	}
-	symbolMP, err := modelPluginModule.Lookup("ModelPlugin")
-	if err != nil {
-		log.Warning("Unable to find ModelPlugin in module ", moduleName)
-		return "", "", err
-	}
-	modelPlugin, ok := symbolMP.(ModelPlugin)
-	if !ok {
-		log.Warning("Unable to use ModelPlugin in ", moduleName)
-		return "", "", fmt.Errorf("symbol loaded from module %s is not a ModelPlugin",
-			moduleName)
-	}
 	name, version, _, _ := modelPlugin.ModelData()
+	if name == "" {
+		modelPlugin.stop()
+		return "", "", fmt.Errorf("unable to read ModelData from model plugin process %s", moduleName)
+	}
 	modelName := utils.ToModelName(name, version)
+	if _, alreadyLoaded := m.ModelRegistry[modelName]; alreadyLoaded {
+		modelPlugin.stop()
+		return "", "", fmt.Errorf("model %s is already registered", modelName)
+	}
 	m.ModelRegistry[modelName] = modelPlugin
 	modelschema, err := modelPlugin.Schema()
 	if err != nil {
 		log.Warning("Error loading schema from model plugin", modelName, err)
+		modelPlugin.stop()
+		delete(m.ModelRegistry, modelName)
 		return "", "", err
 	}
 
 	m.ModelReadOnlyPaths[modelName] = extractReadOnlyPaths(modelschema["Device"],
 		yang.TSUnset, "", "")
-	log.Infof("Model %s %s loaded. %d read only paths", name, version,
-		len(m.ModelReadOnlyPaths[modelName]))
+	m.ModelPathMetadata[modelName] = extractPathMetadata(modelschema["Device"],
+		yang.TSUnset, "", "")
+	log.Infof("Model %s %s loaded. %d read only paths, %d paths with metadata", name, version,
+		len(m.ModelReadOnlyPaths[modelName]), len(m.ModelPathMetadata[modelName]))
 	return name, version, nil
 }
 
+// UnregisterModelPlugin stops the child process backing modelName and removes it from the model
+// registry. Devices that were pinned to this model version must be re-pinned before their next
+// validation or unmarshal call
+func (m *Manager) UnregisterModelPlugin(modelName string) error {
+	modelPlugin, ok := m.ModelRegistry[modelName]
+	if !ok {
+		return fmt.Errorf("model %s is not registered", modelName)
+	}
+	if process, ok := modelPlugin.(*modelPluginProcess); ok {
+		process.stop()
+	}
+	delete(m.ModelRegistry, modelName)
+	delete(m.ModelReadOnlyPaths, modelName)
+	delete(m.ModelPathMetadata, modelName)
+	log.Infof("Model %s unloaded", modelName)
+	return nil
+}
+
+// ReloadModelPlugin unregisters modelName, if currently loaded, and registers the plugin found
+// at path in its place. Since modelName is derived from the plugin's own ModelData, this can be
+// used either to pick up a rebuilt binary for the same model version or to swap in a different
+// version under the same name
+func (m *Manager) ReloadModelPlugin(modelName string, path string) (string, string, error) {
+	if _, ok := m.ModelRegistry[modelName]; ok {
+		if err := m.UnregisterModelPlugin(modelName); err != nil {
+			return "", "", err
+		}
+	}
+	return m.RegisterModelPlugin(path)
+}
+
+// PinDeviceModelVersion pins deviceID to a specific registered model version, so that later
+// calls to ModelPluginForDevice route to that version even after other versions of the same
+// model name are registered
+func (m *Manager) PinDeviceModelVersion(deviceID string, modelName string) error {
+	if _, ok := m.ModelRegistry[modelName]; !ok {
+		return fmt.Errorf("model %s is not registered", modelName)
+	}
+	m.DeviceModelVersion[deviceID] = modelName
+	return nil
+}
+
+// ModelPluginForDevice returns the ModelPlugin that should be used to validate and unmarshal
+// configuration for deviceID: the version it is pinned to via PinDeviceModelVersion, or
+// fallbackModelName if the device has no pin
+func (m *Manager) ModelPluginForDevice(deviceID string, fallbackModelName string) (ModelPlugin, error) {
+	modelName, pinned := m.DeviceModelVersion[deviceID]
+	if !pinned {
+		modelName = fallbackModelName
+	}
+	modelPlugin, ok := m.ModelRegistry[modelName]
+	if !ok {
+		return nil, fmt.Errorf("model %s is not registered", modelName)
+	}
+	return modelPlugin, nil
+}
+
 // Capabilities returns an aggregated set of modelData in gNMI capabilities format
 // with duplicates removed
 func (m *Manager) Capabilities() []*gnmi.ModelData {
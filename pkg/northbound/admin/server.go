@@ -0,0 +1,75 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/onosproject/onos-config/pkg/manager"
+)
+
+// Server implements ConfigAdminServiceServer on top of a *manager.Manager, so that model plugins
+// can be unregistered and reloaded from a running onos-config instance through the admin gRPC
+// interface rather than only from in-process startup code
+type Server struct {
+	mgr *manager.Manager
+}
+
+// NewServer returns an admin Server backed by mgr
+func NewServer(mgr *manager.Manager) *Server {
+	return &Server{mgr: mgr}
+}
+
+// UnregisterModelPlugin stops the model plugin named in the request and removes it from the
+// manager's model registry
+func (s *Server) UnregisterModelPlugin(ctx context.Context, req *UnregisterModelPluginRequest) (*UnregisterModelPluginReply, error) {
+	if err := s.mgr.UnregisterModelPlugin(req.ModelName); err != nil {
+		return nil, err
+	}
+	return &UnregisterModelPluginReply{}, nil
+}
+
+// ReloadModelPlugin unregisters the model plugin named in the request, if loaded, and registers
+// the plugin found at the request's path in its place
+func (s *Server) ReloadModelPlugin(ctx context.Context, req *ReloadModelPluginRequest) (*ReloadModelPluginReply, error) {
+	name, version, err := s.mgr.ReloadModelPlugin(req.ModelName, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &ReloadModelPluginReply{Name: name, Version: version}, nil
+}
+
+// GetPathMetadata returns the type and constraint metadata manager.Manager.PathMetadata has for
+// a single path of a model, so that a client can validate or typecheck a value locally before
+// issuing a gNMI Set RPC instead of discovering errors only at device-apply time
+func (s *Server) GetPathMetadata(ctx context.Context, req *GetPathMetadataRequest) (*GetPathMetadataReply, error) {
+	info, err := s.mgr.PathMetadata(req.ModelName, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &GetPathMetadataReply{
+		Path:        info.Path,
+		IsConfig:    info.IsConfig,
+		Type:        info.Type,
+		Units:       info.Units,
+		Default:     info.Default,
+		Description: info.Description,
+		Mandatory:   info.Mandatory,
+		MinElements: info.MinElements,
+		Enum:        info.Enum,
+		LeafRefPath: info.LeafRefPath,
+		LeafRefType: info.LeafRefType,
+	}, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diags
+
+import (
+	"bytes"
+	"time"
+)
+
+// sampleCoalescer implements SAMPLE stream-mode semantics: it remembers the latest value seen
+// for each path, and Flush releases at most one value per path per sample interval, optionally
+// suppressing a flush whose value is identical to the last one actually sent for that path. Time
+// is passed in rather than read from the clock so the coalescing logic itself is deterministic
+// and unit-testable
+type sampleCoalescer struct {
+	interval          time.Duration
+	suppressRedundant bool
+
+	latest     map[string]*PathValue
+	lastSent   map[string]*PathValue
+	lastSentAt map[string]time.Time
+}
+
+func newSampleCoalescer(interval time.Duration, suppressRedundant bool) *sampleCoalescer {
+	return &sampleCoalescer{
+		interval:          interval,
+		suppressRedundant: suppressRedundant,
+		latest:            make(map[string]*PathValue),
+		lastSent:          make(map[string]*PathValue),
+		lastSentAt:        make(map[string]time.Time),
+	}
+}
+
+// Update records pv as the most recent value seen for its path; it does not itself decide
+// whether/when to emit anything
+func (c *sampleCoalescer) Update(pv *PathValue) {
+	c.latest[pv.Path] = pv
+}
+
+// Flush returns the values that are due to be sent as of now: the latest value of each path seen
+// so far whose sample interval has elapsed since it was last sent, even if no new update arrived
+// for it since then, skipping paths whose latest value is unchanged from the last one sent when
+// suppressRedundant is set
+func (c *sampleCoalescer) Flush(now time.Time) []*PathValue {
+	var due []*PathValue
+	for path, pv := range c.latest {
+		if sentAt, ok := c.lastSentAt[path]; ok && now.Sub(sentAt) < c.interval {
+			continue
+		}
+		if c.suppressRedundant && samePathValue(c.lastSent[path], pv) {
+			c.lastSentAt[path] = now
+			continue
+		}
+		due = append(due, pv)
+		c.lastSent[path] = pv
+		c.lastSentAt[path] = now
+	}
+	return due
+}
+
+// onChangeDeduper implements ON_CHANGE (and TARGET_DEFINED, which this package treats as
+// ON_CHANGE) stream-mode semantics: a value is forwarded when it differs from the last one sent
+// for its path, or when heartbeat has elapsed since that path was last sent regardless of
+// whether the value changed. The heartbeat is only checked when a value for the path is passed
+// to ShouldSend; a path that receives no further values is not sent again
+type onChangeDeduper struct {
+	heartbeat time.Duration
+
+	lastSent   map[string]*PathValue
+	lastSentAt map[string]time.Time
+}
+
+func newOnChangeDeduper(heartbeat time.Duration) *onChangeDeduper {
+	return &onChangeDeduper{
+		heartbeat:  heartbeat,
+		lastSent:   make(map[string]*PathValue),
+		lastSentAt: make(map[string]time.Time),
+	}
+}
+
+// ShouldSend reports whether pv should be forwarded now, and records it as sent if so
+func (d *onChangeDeduper) ShouldSend(pv *PathValue, now time.Time) bool {
+	changed := !samePathValue(d.lastSent[pv.Path], pv)
+	heartbeatDue := d.heartbeat > 0 && now.Sub(d.lastSentAt[pv.Path]) >= d.heartbeat
+	if !changed && !heartbeatDue {
+		return false
+	}
+	d.lastSent[pv.Path] = pv
+	d.lastSentAt[pv.Path] = now
+	return true
+}
+
+func samePathValue(a, b *PathValue) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Path == b.Path && a.ValueType == b.ValueType && bytes.Equal(a.Value, b.Value)
+}
@@ -0,0 +1,111 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diags
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_sampleCoalescer_Flush(t *testing.T) {
+	start := time.Unix(0, 0)
+	interval := 10 * time.Second
+
+	t.Run("nothing to flush before the interval elapses", func(t *testing.T) {
+		c := newSampleCoalescer(interval, false)
+		c.Update(&PathValue{Path: "/a", ValueType: "INT", Value: []byte("1")})
+		if got := c.Flush(start); len(got) != 1 {
+			t.Fatalf("first Flush() = %d values, want 1 (unsent path always flushes immediately)", len(got))
+		}
+		c.Update(&PathValue{Path: "/a", ValueType: "INT", Value: []byte("2")})
+		if got := c.Flush(start.Add(5 * time.Second)); len(got) != 0 {
+			t.Errorf("Flush() before interval elapsed = %d values, want 0", len(got))
+		}
+	})
+
+	t.Run("flushes the latest value once the interval elapses", func(t *testing.T) {
+		c := newSampleCoalescer(interval, false)
+		c.Update(&PathValue{Path: "/a", ValueType: "INT", Value: []byte("1")})
+		c.Flush(start)
+		c.Update(&PathValue{Path: "/a", ValueType: "INT", Value: []byte("2")})
+		c.Update(&PathValue{Path: "/a", ValueType: "INT", Value: []byte("3")})
+
+		got := c.Flush(start.Add(interval))
+		if len(got) != 1 || string(got[0].Value) != "3" {
+			t.Fatalf("Flush() = %v, want the latest value (3)", got)
+		}
+	})
+
+	t.Run("suppressRedundant skips a flush whose value did not change", func(t *testing.T) {
+		c := newSampleCoalescer(interval, true)
+		c.Update(&PathValue{Path: "/a", ValueType: "INT", Value: []byte("1")})
+		c.Flush(start)
+		c.Update(&PathValue{Path: "/a", ValueType: "INT", Value: []byte("1")})
+
+		if got := c.Flush(start.Add(interval)); len(got) != 0 {
+			t.Errorf("Flush() with suppressRedundant and an unchanged value = %d values, want 0", len(got))
+		}
+	})
+
+	t.Run("each path is coalesced independently", func(t *testing.T) {
+		c := newSampleCoalescer(interval, false)
+		c.Update(&PathValue{Path: "/a", Value: []byte("1")})
+		c.Flush(start)
+		c.Update(&PathValue{Path: "/b", Value: []byte("1")})
+
+		got := c.Flush(start.Add(time.Second))
+		if len(got) != 1 || got[0].Path != "/b" {
+			t.Fatalf("Flush() = %v, want only the newly-seen path /b", got)
+		}
+	})
+}
+
+func Test_onChangeDeduper_ShouldSend(t *testing.T) {
+	start := time.Unix(0, 0)
+
+	t.Run("first value for a path is always sent", func(t *testing.T) {
+		d := newOnChangeDeduper(0)
+		if !d.ShouldSend(&PathValue{Path: "/a", Value: []byte("1")}, start) {
+			t.Error("ShouldSend() = false for a path with no prior value, want true")
+		}
+	})
+
+	t.Run("an unchanged value is suppressed", func(t *testing.T) {
+		d := newOnChangeDeduper(0)
+		d.ShouldSend(&PathValue{Path: "/a", Value: []byte("1")}, start)
+		if d.ShouldSend(&PathValue{Path: "/a", Value: []byte("1")}, start.Add(time.Second)) {
+			t.Error("ShouldSend() = true for an unchanged value with no heartbeat, want false")
+		}
+	})
+
+	t.Run("a changed value is always sent", func(t *testing.T) {
+		d := newOnChangeDeduper(0)
+		d.ShouldSend(&PathValue{Path: "/a", Value: []byte("1")}, start)
+		if !d.ShouldSend(&PathValue{Path: "/a", Value: []byte("2")}, start.Add(time.Second)) {
+			t.Error("ShouldSend() = false for a changed value, want true")
+		}
+	})
+
+	t.Run("heartbeat forces a resend of an unchanged value once it elapses", func(t *testing.T) {
+		d := newOnChangeDeduper(10 * time.Second)
+		d.ShouldSend(&PathValue{Path: "/a", Value: []byte("1")}, start)
+		if d.ShouldSend(&PathValue{Path: "/a", Value: []byte("1")}, start.Add(5*time.Second)) {
+			t.Error("ShouldSend() before heartbeat elapsed = true, want false")
+		}
+		if !d.ShouldSend(&PathValue{Path: "/a", Value: []byte("1")}, start.Add(10*time.Second)) {
+			t.Error("ShouldSend() once heartbeat elapsed = false, want true")
+		}
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diags
+
+import (
+	"time"
+)
+
+// defaultSampleInterval is used when a STREAM/SAMPLE request does not set sample_interval
+const defaultSampleInterval = 10 * time.Second
+
+// Cache is the subset of the opstate cache that OpStateDiags.GetOpState needs: the current
+// snapshot of values for a device, and a feed of subsequent changes. The real cache
+// implementation lives alongside whatever keeps it up to date from southbound gNMI Subscribe
+// sessions; Server only depends on this interface so it can be unit tested against a fake
+type Cache interface {
+	// Get returns the current value of every path held for deviceID, restricted to paths if it
+	// is non-empty
+	Get(deviceID string, paths []string) ([]*PathValue, error)
+	// Subscribe returns a channel of every subsequent value change for deviceID and an
+	// unsubscribe function the caller must invoke exactly once when it stops reading
+	Subscribe(deviceID string) (updates <-chan *PathValue, unsubscribe func(), err error)
+}
+
+// Server implements OpStateDiagsServer on top of a Cache
+type Server struct {
+	cache Cache
+}
+
+// NewServer returns a diags Server backed by cache
+func NewServer(cache Cache) *Server {
+	return &Server{cache: cache}
+}
+
+// GetOpState streams the current opstate cache snapshot for the requested device, followed by a
+// sync_response marker and subsequent updates, unless mode is ONCE.
+//
+// POLL is treated the same as ONCE: this RPC takes a single request and only streams server ->
+// client, so there is no channel for the client to send the repeated poll triggers real gNMI
+// Poll requires. Modelling that needs a bidi-streaming RPC, which is follow-up work beyond this
+// request's CLI/cache-subscriber scope
+func (s *Server) GetOpState(req *OpStateRequest, stream OpStateDiags_GetOpStateServer) error {
+	snapshot, err := s.cache.Get(req.DeviceId, req.Paths)
+	if err != nil {
+		return err
+	}
+	for _, pv := range snapshot {
+		if err := stream.Send(&OpStateResponse{DeviceId: req.DeviceId, Pathvalue: pv}); err != nil {
+			return err
+		}
+	}
+
+	mode := req.Mode
+	if req.Subscribe && mode == SubscribeMode_ONCE {
+		mode = SubscribeMode_STREAM // Subscribe is the older once/stream-only request shape
+	}
+	if mode != SubscribeMode_STREAM {
+		return nil
+	}
+
+	if err := stream.Send(&OpStateResponse{DeviceId: req.DeviceId, SyncResponse: true}); err != nil {
+		return err
+	}
+
+	updates, unsubscribe, err := s.cache.Subscribe(req.DeviceId)
+	if err != nil {
+		return err
+	}
+	defer unsubscribe()
+
+	return s.streamUpdates(req, stream, updates)
+}
+
+func (s *Server) streamUpdates(req *OpStateRequest, stream OpStateDiags_GetOpStateServer, updates <-chan *PathValue) error {
+	switch req.StreamMode {
+	case SubscriptionMode_SAMPLE:
+		return s.streamSampled(req, stream, updates)
+	default:
+		return s.streamOnChange(req, stream, updates)
+	}
+}
+
+func (s *Server) streamOnChange(req *OpStateRequest, stream OpStateDiags_GetOpStateServer, updates <-chan *PathValue) error {
+	deduper := newOnChangeDeduper(time.Duration(req.HeartbeatInterval))
+	for pv := range updates {
+		if !deduper.ShouldSend(pv, time.Now()) {
+			continue
+		}
+		if err := stream.Send(&OpStateResponse{DeviceId: req.DeviceId, Pathvalue: pv}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) streamSampled(req *OpStateRequest, stream OpStateDiags_GetOpStateServer, updates <-chan *PathValue) error {
+	interval := time.Duration(req.SampleInterval)
+	if interval <= 0 {
+		interval = defaultSampleInterval
+	}
+	coalescer := newSampleCoalescer(interval, req.SuppressRedundant)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case pv, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			coalescer.Update(pv)
+		case now := <-ticker.C:
+			for _, pv := range coalescer.Flush(now) {
+				if err := stream.Send(&OpStateResponse{DeviceId: req.DeviceId, Pathvalue: pv}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
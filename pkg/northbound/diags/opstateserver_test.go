@@ -0,0 +1,128 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diags
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeCache is a Cache backed by an in-memory snapshot and a single updates channel, enough to
+// drive Server.GetOpState in tests without a real opstate cache
+type fakeCache struct {
+	snapshot []*PathValue
+	updates  chan *PathValue
+}
+
+func (c *fakeCache) Get(deviceID string, paths []string) ([]*PathValue, error) {
+	return c.snapshot, nil
+}
+
+func (c *fakeCache) Subscribe(deviceID string) (<-chan *PathValue, func(), error) {
+	return c.updates, func() {}, nil
+}
+
+// fakeStream is a minimal grpc.ServerStream that records every OpStateResponse sent to it
+type fakeStream struct {
+	mu  sync.Mutex
+	out []*OpStateResponse
+}
+
+func (s *fakeStream) Send(m *OpStateResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.out = append(s.out, m)
+	return nil
+}
+
+func (s *fakeStream) responses() []*OpStateResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*OpStateResponse{}, s.out...)
+}
+
+func (s *fakeStream) SetHeader(metadata.MD) error  { return nil }
+func (s *fakeStream) SendHeader(metadata.MD) error { return nil }
+func (s *fakeStream) SetTrailer(metadata.MD)       {}
+func (s *fakeStream) Context() context.Context     { return context.Background() }
+func (s *fakeStream) SendMsg(m interface{}) error  { return nil }
+func (s *fakeStream) RecvMsg(m interface{}) error  { return nil }
+
+func Test_Server_GetOpState_once(t *testing.T) {
+	cache := &fakeCache{snapshot: []*PathValue{{Path: "/a", Value: []byte("1")}}}
+	stream := &fakeStream{}
+
+	if err := NewServer(cache).GetOpState(&OpStateRequest{DeviceId: "dev1", Mode: SubscribeMode_ONCE}, stream); err != nil {
+		t.Fatalf("GetOpState() error = %v", err)
+	}
+
+	got := stream.responses()
+	if len(got) != 1 || got[0].Pathvalue.Path != "/a" {
+		t.Fatalf("GetOpState() responses = %v, want exactly the snapshot with no sync_response", got)
+	}
+}
+
+func Test_Server_GetOpState_stream_sendsSnapshotThenSyncResponseThenUpdates(t *testing.T) {
+	cache := &fakeCache{
+		snapshot: []*PathValue{{Path: "/a", Value: []byte("1")}},
+		updates:  make(chan *PathValue, 1),
+	}
+	stream := &fakeStream{}
+
+	cache.updates <- &PathValue{Path: "/a", Value: []byte("2")}
+	close(cache.updates)
+
+	req := &OpStateRequest{DeviceId: "dev1", Mode: SubscribeMode_STREAM, StreamMode: SubscriptionMode_ON_CHANGE}
+	if err := NewServer(cache).GetOpState(req, stream); err != nil {
+		t.Fatalf("GetOpState() error = %v", err)
+	}
+
+	got := stream.responses()
+	if len(got) != 3 {
+		t.Fatalf("GetOpState() sent %d responses, want 3 (snapshot, sync_response, update)", len(got))
+	}
+	if got[0].Pathvalue == nil || got[0].Pathvalue.Path != "/a" || string(got[0].Pathvalue.Value) != "1" {
+		t.Errorf("response[0] = %v, want the initial snapshot value", got[0])
+	}
+	if !got[1].SyncResponse || got[1].Pathvalue != nil {
+		t.Errorf("response[1] = %v, want a bare sync_response marker", got[1])
+	}
+	if got[2].Pathvalue == nil || string(got[2].Pathvalue.Value) != "2" {
+		t.Errorf("response[2] = %v, want the streamed update value", got[2])
+	}
+}
+
+func Test_Server_GetOpState_onChange_dropsUnchangedUpdates(t *testing.T) {
+	cache := &fakeCache{updates: make(chan *PathValue, 2)}
+	stream := &fakeStream{}
+
+	cache.updates <- &PathValue{Path: "/a", Value: []byte("1")}
+	cache.updates <- &PathValue{Path: "/a", Value: []byte("1")}
+	close(cache.updates)
+
+	req := &OpStateRequest{DeviceId: "dev1", Mode: SubscribeMode_STREAM, StreamMode: SubscriptionMode_ON_CHANGE}
+	if err := NewServer(cache).GetOpState(req, stream); err != nil {
+		t.Fatalf("GetOpState() error = %v", err)
+	}
+
+	got := stream.responses()
+	// sync_response + one update; the second, identical update must be deduped
+	if len(got) != 2 {
+		t.Fatalf("GetOpState() sent %d responses, want 2 (sync_response + one deduped update)", len(got))
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginregistry
+
+import (
+	"encoding/json"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// EncodeSchema JSON-encodes a YGOT schema for transport in a SchemaReply. yang.Entry.Parent is a
+// back-reference to the enclosing entry, which would make a direct json.Marshal recurse forever,
+// so each entry is walked and its Parent is cleared before encoding.
+//
+// yang.Entry.Node, the link back to the parsed YANG statement, is not JSON-serialisable and is
+// dropped by this round trip along with it; extractReadOnlyPaths and extractPathMetadata do not
+// use it, but any future consumer of the manager-side schema that does will need a different
+// transport for that field
+func EncodeSchema(schema map[string]*yang.Entry) ([]byte, error) {
+	for _, entry := range schema {
+		clearParent(entry, make(map[*yang.Entry]bool))
+	}
+	return json.Marshal(schema)
+}
+
+// DecodeSchema reverses EncodeSchema, reconstructing each entry's Parent link from the Dir tree
+// that is preserved across the JSON round trip
+func DecodeSchema(entriesJSON []byte) (map[string]*yang.Entry, error) {
+	schema := make(map[string]*yang.Entry)
+	if err := json.Unmarshal(entriesJSON, &schema); err != nil {
+		return nil, err
+	}
+	for _, entry := range schema {
+		relinkParent(entry, nil)
+	}
+	return schema, nil
+}
+
+func clearParent(entry *yang.Entry, seen map[*yang.Entry]bool) {
+	if entry == nil || seen[entry] {
+		return
+	}
+	seen[entry] = true
+	entry.Parent = nil
+	for _, child := range entry.Dir {
+		clearParent(child, seen)
+	}
+}
+
+func relinkParent(entry *yang.Entry, parent *yang.Entry) {
+	if entry == nil {
+		return
+	}
+	entry.Parent = parent
+	for _, child := range entry.Dir {
+		relinkParent(child, entry)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginregistry
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc"
+	log "k8s.io/klog"
+)
+
+// ModelPlugin is the interface a model plugin binary implements. It is a copy of
+// manager.ModelPlugin rather than a shared import, because manager imports this package to dial
+// a plugin process and an import the other way would create a cycle
+type ModelPlugin interface {
+	ModelData() (string, string, []*gnmi.ModelData, string)
+	UnmarshalConfigValues(jsonTree []byte) (*ygot.ValidatedGoStruct, error)
+	Validate(*ygot.ValidatedGoStruct, ...ygot.ValidationOption) error
+	Schema() (map[string]*yang.Entry, error)
+}
+
+// Serve runs plugin as a ModelPluginService over a gRPC listener on the given unix socket path
+// until the process is killed. A model plugin binary's main function calls this once its
+// ModelPlugin implementation is ready
+func Serve(socket string, plugin ModelPlugin) error {
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("unable to listen on model plugin socket %s: %w", socket, err)
+	}
+	server := grpc.NewServer()
+	RegisterModelPluginServiceServer(server, newModelPluginServer(plugin))
+	return server.Serve(lis)
+}
+
+// modelPluginServer adapts a ModelPlugin to ModelPluginServiceServer, keeping the config trees
+// that UnmarshalConfigValues decodes in memory under a handle so that a later Validate call can
+// be routed back to the same *ygot.ValidatedGoStruct. A handle is single-use: the client always
+// pairs one UnmarshalConfigValues call with exactly one following Validate call and then discards
+// the tree (see remoteGoStruct in pkg/manager/modelpluginprocess.go), so Validate evicts the
+// handle once it has read it, win or lose, rather than requiring an explicit release call or
+// ageing handles out on a TTL
+type modelPluginServer struct {
+	plugin ModelPlugin
+
+	mu      sync.Mutex
+	handles map[string]*ygot.ValidatedGoStruct
+	nextID  uint64
+}
+
+func newModelPluginServer(plugin ModelPlugin) *modelPluginServer {
+	return &modelPluginServer{
+		plugin:  plugin,
+		handles: make(map[string]*ygot.ValidatedGoStruct),
+	}
+}
+
+func (s *modelPluginServer) ModelData(context.Context, *ModelDataRequest) (*ModelDataReply, error) {
+	name, version, models, module := s.plugin.ModelData()
+	return &ModelDataReply{Name: name, Version: version, Models: models, Module: module}, nil
+}
+
+func (s *modelPluginServer) UnmarshalConfigValues(ctx context.Context, req *UnmarshalConfigValuesRequest) (*UnmarshalConfigValuesReply, error) {
+	goStruct, err := s.plugin.UnmarshalConfigValues(req.JsonTree)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.nextID++
+	handle := fmt.Sprintf("%d", s.nextID)
+	s.handles[handle] = goStruct
+	s.mu.Unlock()
+	return &UnmarshalConfigValuesReply{Handle: handle}, nil
+}
+
+func (s *modelPluginServer) Validate(ctx context.Context, req *ValidateRequest) (*ValidateReply, error) {
+	s.mu.Lock()
+	goStruct, ok := s.handles[req.Handle]
+	delete(s.handles, req.Handle)
+	s.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown config tree handle %s", req.Handle)
+	}
+	if err := s.plugin.Validate(goStruct); err != nil {
+		return nil, err
+	}
+	return &ValidateReply{}, nil
+}
+
+func (s *modelPluginServer) Schema(context.Context, *SchemaRequest) (*SchemaReply, error) {
+	schema, err := s.plugin.Schema()
+	if err != nil {
+		return nil, err
+	}
+	entriesJSON, err := EncodeSchema(schema)
+	if err != nil {
+		log.Warning("Error encoding schema for transport", err)
+		return nil, err
+	}
+	return &SchemaReply{EntriesJson: entriesJSON}, nil
+}